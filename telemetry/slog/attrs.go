@@ -0,0 +1,212 @@
+package slog
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"golang.org/x/exp/slog"
+)
+
+// recordAttrs collects r's attributes into a slice so they can be passed through
+// flattenAttrs. slog.Record only exposes attributes via a callback-based iterator.
+func recordAttrs(r slog.Record) []slog.Attr {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
+
+// findError returns the first top-level attribute on r whose value implements error, for use
+// with WithRecordException.
+func findError(r slog.Record) (error, bool) {
+	var found error
+	ok := false
+	r.Attrs(func(a slog.Attr) bool {
+		if err, isErr := a.Value.Resolve().Any().(error); isErr {
+			found = err
+			ok = true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+// joinKey joins a dotted group prefix with a key, per the slog convention for group nesting.
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	if key == "" {
+		return prefix
+	}
+	return prefix + "." + key
+}
+
+// flattenAttrs walks attrs, honoring the slog rule that an empty key is skipped, resolving
+// any slog.LogValuer, and recursively flattening slog.GroupValue into dotted keys under
+// prefix. Each resulting leaf (key, value) pair is converted to T via leaf.
+func flattenAttrs[T any](prefix string, attrs []slog.Attr, leaf func(key string, v slog.Value) T) []T {
+	out := make([]T, 0, len(attrs))
+	for _, a := range attrs {
+		if a.Key == "" {
+			continue
+		}
+		v := a.Value.Resolve()
+		key := joinKey(prefix, a.Key)
+		if v.Kind() == slog.KindGroup {
+			out = append(out, flattenAttrs(key, v.Group(), leaf)...)
+			continue
+		}
+		out = append(out, leaf(key, v))
+	}
+	return out
+}
+
+// toAttribute converts a resolved, non-group slog.Value to an attribute.KeyValue, preserving
+// its type where attribute.KeyValue has a matching constructor. s.attrMapper, if set, is
+// consulted first so callers can preserve types for their own custom values.
+func (s otel) toAttribute(key string, v slog.Value) attribute.KeyValue {
+	if s.attrMapper != nil {
+		if av, ok := s.attrMapper(v); ok {
+			return attribute.KeyValue{Key: attribute.Key(key), Value: av}
+		}
+	}
+
+	switch v.Kind() {
+	case slog.KindBool:
+		return attribute.Bool(key, v.Bool())
+	case slog.KindInt64:
+		return attribute.Int64(key, v.Int64())
+	case slog.KindUint64:
+		return attribute.Int64(key, int64(v.Uint64()))
+	case slog.KindFloat64:
+		return attribute.Float64(key, v.Float64())
+	case slog.KindDuration:
+		return attribute.String(key, v.Duration().String())
+	case slog.KindTime:
+		return attribute.String(key, v.Time().Format(time.RFC3339Nano))
+	case slog.KindString:
+		return attribute.String(key, v.String())
+	default:
+		switch a := v.Any().(type) {
+		case []string:
+			return attribute.StringSlice(key, a)
+		case []int64:
+			return attribute.Int64Slice(key, a)
+		case []float64:
+			return attribute.Float64Slice(key, a)
+		case []bool:
+			return attribute.BoolSlice(key, a)
+		default:
+			return attribute.String(key, v.String())
+		}
+	}
+}
+
+// toLogKeyValue converts a resolved, non-group slog.Value to a log.KeyValue for the OTEL
+// Logs Bridge, mirroring toAttribute's type preservation. s.attrMapper, if set, is consulted
+// first, same as toAttribute, so a caller's custom values keep their type on both emission
+// paths.
+func (s otel) toLogKeyValue(key string, v slog.Value) log.KeyValue {
+	if s.attrMapper != nil {
+		if av, ok := s.attrMapper(v); ok {
+			return log.KeyValue{Key: key, Value: attributeToLogValue(av)}
+		}
+	}
+
+	switch v.Kind() {
+	case slog.KindBool:
+		return log.Bool(key, v.Bool())
+	case slog.KindInt64:
+		return log.Int64(key, v.Int64())
+	case slog.KindUint64:
+		return log.Int64(key, int64(v.Uint64()))
+	case slog.KindFloat64:
+		return log.Float64(key, v.Float64())
+	case slog.KindDuration:
+		return log.String(key, v.Duration().String())
+	case slog.KindTime:
+		return log.String(key, v.Time().Format(time.RFC3339Nano))
+	case slog.KindString:
+		return log.String(key, v.String())
+	default:
+		switch a := v.Any().(type) {
+		case []string:
+			vals := make([]log.Value, len(a))
+			for i, str := range a {
+				vals[i] = log.StringValue(str)
+			}
+			return log.KeyValue{Key: key, Value: log.SliceValue(vals...)}
+		case []int64:
+			vals := make([]log.Value, len(a))
+			for i, n := range a {
+				vals[i] = log.Int64Value(n)
+			}
+			return log.KeyValue{Key: key, Value: log.SliceValue(vals...)}
+		case []float64:
+			vals := make([]log.Value, len(a))
+			for i, f := range a {
+				vals[i] = log.Float64Value(f)
+			}
+			return log.KeyValue{Key: key, Value: log.SliceValue(vals...)}
+		case []bool:
+			vals := make([]log.Value, len(a))
+			for i, b := range a {
+				vals[i] = log.BoolValue(b)
+			}
+			return log.KeyValue{Key: key, Value: log.SliceValue(vals...)}
+		default:
+			return log.String(key, v.String())
+		}
+	}
+}
+
+// attributeToLogValue converts an attribute.Value, as returned by an AttributeMapper, to the
+// matching log.Value so custom type-preservation logic applies the same way to both the span
+// event and Logs Bridge emission paths.
+func attributeToLogValue(v attribute.Value) log.Value {
+	switch v.Type() {
+	case attribute.BOOL:
+		return log.BoolValue(v.AsBool())
+	case attribute.INT64:
+		return log.Int64Value(v.AsInt64())
+	case attribute.FLOAT64:
+		return log.Float64Value(v.AsFloat64())
+	case attribute.STRING:
+		return log.StringValue(v.AsString())
+	case attribute.BOOLSLICE:
+		bs := v.AsBoolSlice()
+		vals := make([]log.Value, len(bs))
+		for i, b := range bs {
+			vals[i] = log.BoolValue(b)
+		}
+		return log.SliceValue(vals...)
+	case attribute.INT64SLICE:
+		is := v.AsInt64Slice()
+		vals := make([]log.Value, len(is))
+		for i, n := range is {
+			vals[i] = log.Int64Value(n)
+		}
+		return log.SliceValue(vals...)
+	case attribute.FLOAT64SLICE:
+		fs := v.AsFloat64Slice()
+		vals := make([]log.Value, len(fs))
+		for i, f := range fs {
+			vals[i] = log.Float64Value(f)
+		}
+		return log.SliceValue(vals...)
+	case attribute.STRINGSLICE:
+		ss := v.AsStringSlice()
+		vals := make([]log.Value, len(ss))
+		for i, str := range ss {
+			vals[i] = log.StringValue(str)
+		}
+		return log.SliceValue(vals...)
+	default:
+		return log.StringValue(v.Emit())
+	}
+}
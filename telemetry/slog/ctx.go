@@ -0,0 +1,82 @@
+package slog
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/exp/slog"
+)
+
+// ctxAttrsKey is the context.Context key under which AppendCtx/PrependCtx store attrs.
+type ctxAttrsKey struct{}
+
+var (
+	extractorsMu sync.RWMutex
+	extractors   []func(context.Context) []slog.Attr
+)
+
+// RegisterContextExtractor registers f to run for every record handled by an otel handler,
+// so that code deep in a call stack (request IDs, tenant, feature flags) can enrich logs
+// without threading a logger through. Extractors run in registration order; register them
+// during package init, before any logging happens, to get deterministic attribute order.
+func RegisterContextExtractor(f func(ctx context.Context) []slog.Attr) {
+	extractorsMu.Lock()
+	defer extractorsMu.Unlock()
+	extractors = append(extractors, f)
+}
+
+// contextExtractors returns a snapshot of the registered extractors.
+func contextExtractors() []func(context.Context) []slog.Attr {
+	extractorsMu.RLock()
+	defer extractorsMu.RUnlock()
+	return append([]func(context.Context) []slog.Attr{}, extractors...)
+}
+
+// AppendCtx returns a copy of ctx with attrs added after any attrs already attached by a
+// prior AppendCtx/PrependCtx call. Use this to enrich logs from deep in a call stack without
+// threading a logger.
+func AppendCtx(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if ctx == nil || len(attrs) == 0 {
+		return ctx
+	}
+	existing := ExtractCtx(ctx)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, ctxAttrsKey{}, merged)
+}
+
+// PrependCtx is like AppendCtx, but places attrs before any attrs already attached by a prior
+// AppendCtx/PrependCtx call, so they take precedence in handlers that let earlier attrs with
+// the same key win.
+func PrependCtx(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if ctx == nil || len(attrs) == 0 {
+		return ctx
+	}
+	existing := ExtractCtx(ctx)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, attrs...)
+	merged = append(merged, existing...)
+	return context.WithValue(ctx, ctxAttrsKey{}, merged)
+}
+
+// ExtractCtx returns the attrs attached to ctx by AppendCtx/PrependCtx, in the order they
+// were assembled. It does not run registered context extractors.
+func ExtractCtx(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	existing, _ := ctx.Value(ctxAttrsKey{}).([]slog.Attr)
+	return append([]slog.Attr{}, existing...)
+}
+
+// collectCtxAttrs combines the attrs attached via AppendCtx/PrependCtx with the output of
+// every registered context extractor. Ordering is: ctx-attached attrs first (as assembled by
+// AppendCtx/PrependCtx), followed by each registered extractor's attrs in registration order.
+func collectCtxAttrs(ctx context.Context) []slog.Attr {
+	attrs := ExtractCtx(ctx)
+	for _, f := range contextExtractors() {
+		attrs = append(attrs, f(ctx)...)
+	}
+	return attrs
+}
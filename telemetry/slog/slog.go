@@ -27,6 +27,17 @@ Example usage with default logger (package main):
 
 The above will always use the default logger whenever logging is done. If there is an active
 OTEL span, it will log to that span too along with our trace messages.
+
+Example usage with the OTEL Logs Bridge (package main):
+
+	func main() {
+		lp := global.GetLoggerProvider() // or whatever LoggerProvider your SDK is configured with.
+		slog.SetDefault(events.NewOTELWithLoggerProvider(nil, lp))
+	}
+
+The above emits records through the LoggerProvider's Logger instead of (or in addition to, with
+ModeBoth) adding them as span events, which is the data model the OTel collector expects logs to
+arrive in.
 */
 package slog
 
@@ -34,7 +45,9 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slog"
 )
@@ -43,47 +56,296 @@ import (
 // the slog documentation recommendation.
 const LevelTrace = slog.Level(-8)
 
+// defaultBaggageKeyPrefix is prepended to baggage member keys when they are added as
+// attributes, so that they don't collide with attributes the caller already set.
+const defaultBaggageKeyPrefix = "baggage."
+
+// instrumentationName identifies this package as the emitter of records sent through the
+// OTEL Logs Bridge.
+const instrumentationName = "github.com/gostdlib/foundation/telemetry/slog"
+
+// Mode controls how this handler emits records to the OTEL SDK.
+type Mode int
+
+const (
+	// ModeSpanEvent adds the log record as an event on the active span. This is the
+	// historical behavior of this package.
+	ModeSpanEvent Mode = iota
+	// ModeLogsBridge emits the log record through the LoggerProvider configured with
+	// NewOTELWithLoggerProvider, using the OTel Logs Bridge API. This is the data model
+	// the OTel collector expects logs to arrive in, and allows logs to be exported even
+	// when no span is active.
+	ModeLogsBridge
+	// ModeBoth does both of the above.
+	ModeBoth
+)
+
+// Option configures the handler returned by NewOTEL or NewOTELWithLoggerProvider.
+type Option func(*otel)
+
+// WithBaggage enables propagation of OTEL baggage.Baggage members found on the context into
+// the log record and the span event as attributes. It is disabled by default.
+func WithBaggage(b bool) Option {
+	return func(o *otel) {
+		o.baggage = b
+	}
+}
+
+// WithBaggageKeyPrefix sets the prefix added to baggage member keys before they are added as
+// attributes, so they can be namespaced to avoid collision with attributes the caller already
+// set. It has no effect unless WithBaggage(true) is also used. The default is "baggage.".
+func WithBaggageKeyPrefix(prefix string) Option {
+	return func(o *otel) {
+		o.baggageKeyPrefix = prefix
+	}
+}
+
+// WithMode overrides the default emission mode. NewOTEL defaults to ModeSpanEvent;
+// NewOTELWithLoggerProvider defaults to ModeLogsBridge. ModeLogsBridge and ModeBoth are
+// no-ops for the Logs Bridge side unless a log.Logger is configured (i.e. the handler was
+// built with NewOTELWithLoggerProvider).
+func WithMode(m Mode) Option {
+	return func(o *otel) {
+		o.mode = m
+	}
+}
+
+// AttributeMapper lets a caller override how an slog.Value is converted to an OTel
+// attribute.Value. Return ok == false to fall back to the built-in conversion.
+type AttributeMapper func(v slog.Value) (av attribute.Value, ok bool)
+
+// WithAttributeMapper registers a hook invoked for every attribute value before the built-in
+// Kind-based conversion is applied. This lets callers preserve types for their own custom
+// slog.Value wrappers instead of having them fall back to a stringified value.
+func WithAttributeMapper(m AttributeMapper) Option {
+	return func(o *otel) {
+		o.attrMapper = m
+	}
+}
+
+// WithMinLevel sets a minimum level below which Enabled reports false, regardless of what
+// the wrapped handler reports. Enabled always still defers to the wrapped handler, so the
+// effective minimum is whichever of the two is stricter.
+func WithMinLevel(l slog.Leveler) Option {
+	return func(o *otel) {
+		o.minLevel = l
+	}
+}
+
+// WithErrorStatus controls whether a recording span has its status set to codes.Error via
+// span.SetStatus when a record at LevelError or above is handled. Defaults to true.
+func WithErrorStatus(b bool) Option {
+	return func(o *otel) {
+		o.errorStatus = b
+	}
+}
+
+// WithRecordException controls whether, for a record at LevelError or above that carries an
+// error-typed attribute (e.g. slog.Any("error", err)), the error is additionally recorded on
+// the span via span.RecordError, per OTel's semantic conventions for exceptions. Defaults to
+// false.
+func WithRecordException(b bool) Option {
+	return func(o *otel) {
+		o.recordException = b
+	}
+}
+
+// SeverityMapper maps an slog.Level to the severity text and number used for the
+// semantic-convention severity attributes/fields. The number should follow the OTel log data
+// model's 1-24 range (e.g. DEBUG=5, INFO=9, WARN=13, ERROR=17).
+type SeverityMapper func(level slog.Level) (text string, number int)
+
+// WithSeverityMapper overrides how an slog.Level is mapped to the severity text and number
+// attached to span events and Logs Bridge records. The default mapper follows the OTel log
+// data model: LevelTrace=1, Debug=5, Info=9, Warn=13, Error=17.
+func WithSeverityMapper(m SeverityMapper) Option {
+	return func(o *otel) {
+		o.severityMapper = m
+	}
+}
+
+// AttributeKeys names the keys used for the severity/body/trace attributes this package adds
+// to span events and log records. Fields left as the empty string keep their default.
+type AttributeKeys struct {
+	Severity       string
+	SeverityNumber string
+	Body           string
+	TraceID        string
+	SpanID         string
+	TraceFlags     string
+}
+
+// defaultAttributeKeys follows the OTel semantic conventions for logs
+// (https://opentelemetry.io/docs/specs/semconv/general/logs/) for the severity/body keys.
+var defaultAttributeKeys = AttributeKeys{
+	Severity:       "log.severity_text",
+	SeverityNumber: "log.severity_number",
+	Body:           "log.body",
+	TraceID:        "traceId",
+	SpanID:         "spanId",
+	TraceFlags:     "trace_flags",
+}
+
+// WithAttributeKeys overrides the attribute keys used for the severity/body/trace attributes,
+// so callers can conform to a backend that expects different names. Any field left as the
+// empty string keeps its default.
+func WithAttributeKeys(keys AttributeKeys) Option {
+	return func(o *otel) {
+		if keys.Severity != "" {
+			o.keys.Severity = keys.Severity
+		}
+		if keys.SeverityNumber != "" {
+			o.keys.SeverityNumber = keys.SeverityNumber
+		}
+		if keys.Body != "" {
+			o.keys.Body = keys.Body
+		}
+		if keys.TraceID != "" {
+			o.keys.TraceID = keys.TraceID
+		}
+		if keys.SpanID != "" {
+			o.keys.SpanID = keys.SpanID
+		}
+		if keys.TraceFlags != "" {
+			o.keys.TraceFlags = keys.TraceFlags
+		}
+	}
+}
+
 // otelHandler implements slog.Handler
 // It adds;
 // (a) TraceIds & spanIds to logs.
-// (b) Logs(as events) to the active span.
+// (b) Logs(as events) to the active span and/or through the OTEL Logs Bridge.
 // This code is borrowed from: https://github.com/komuw/otero/blob/v0.0.1/log/slog.go
 // Updated to work with new versions of slog and other minor changes.
-type otel struct{ h slog.Handler }
+type otel struct {
+	h slog.Handler
+
+	baggage          bool
+	baggageKeyPrefix string
+
+	mode   Mode
+	logger log.Logger
+
+	attrMapper AttributeMapper
+
+	minLevel        slog.Leveler
+	errorStatus     bool
+	recordException bool
+
+	severityMapper SeverityMapper
+	keys           AttributeKeys
+
+	// groupPrefix is the dotted prefix built up by WithGroup calls. preSpanAttrs and
+	// preLogAttrs are the attributes accumulated by WithAttrs calls, already converted and
+	// flattened at the groupPrefix that was in effect when WithAttrs was called.
+	groupPrefix  string
+	preSpanAttrs []attribute.KeyValue
+	preLogAttrs  []log.KeyValue
+}
 
 // NewOTEL provides a new slog.Handler that can log to an active span.
 // If there is no active span, it logs to the logger handler provided.
 // If h == nil, it will use slog.Default() as the logger handler. If that
 // handler is a of the OTEL type, it will use that Handler's wrapped handler.
-// The underlying handler is always logged to.
-func NewOTEL(h slog.Handler) slog.Handler {
-	if h == nil {
+// The underlying handler is always logged to. Emission defaults to ModeSpanEvent; use
+// NewOTELWithLoggerProvider if you want records sent through the OTEL Logs Bridge instead.
+func NewOTEL(h slog.Handler, opts ...Option) slog.Handler {
+	o := newOtel(h)
+	o.mode = ModeSpanEvent
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// NewOTELWithLoggerProvider is like NewOTEL, but emits records through lp's Logger using the
+// OTEL Logs Bridge API (go.opentelemetry.io/otel/log), in addition to the underlying handler
+// provided. This properly separates logs from traces per the OTel data model and allows logs
+// to be exported via OTLP even when no span is active. Emission defaults to ModeLogsBridge;
+// pass WithMode(ModeBoth) to also add span events.
+func NewOTELWithLoggerProvider(h slog.Handler, lp log.LoggerProvider, opts ...Option) slog.Handler {
+	o := newOtel(h)
+	o.mode = ModeLogsBridge
+	o.logger = lp.Logger(instrumentationName)
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+func newOtel(h slog.Handler) otel {
+	o := otel{
+		baggageKeyPrefix: defaultBaggageKeyPrefix,
+		errorStatus:      true,
+		severityMapper:   defaultSeverityMapper,
+		keys:             defaultAttributeKeys,
+	}
+	switch {
+	case h == nil:
 		d := slog.Default()
 		if v, ok := d.Handler().(otel); ok {
-			return otel{v.h}
+			o.h = v.h
+		} else {
+			o.h = slog.Default().Handler()
 		}
-		return otel{slog.Default().Handler()}
+	default:
+		o.h = h
 	}
-	return otel{h}
+	return o
 }
 
-func (s otel) Enabled(_ context.Context, _ slog.Level) bool {
-	return true /* support all logging levels*/
+func (s otel) Enabled(ctx context.Context, level slog.Level) bool {
+	if s.minLevel != nil && level < s.minLevel.Level() {
+		return false
+	}
+	return s.h.Enabled(ctx, level)
 }
 
 func (s otel) WithAttrs(attrs []slog.Attr) slog.Handler {
-	return otel{h: s.h.WithAttrs(attrs)}
+	s.preSpanAttrs = append(
+		append([]attribute.KeyValue{}, s.preSpanAttrs...),
+		flattenAttrs(s.groupPrefix, attrs, s.toAttribute)...,
+	)
+	s.preLogAttrs = append(
+		append([]log.KeyValue{}, s.preLogAttrs...),
+		flattenAttrs(s.groupPrefix, attrs, s.toLogKeyValue)...,
+	)
+	s.h = s.h.WithAttrs(attrs)
+	return s
 }
 
 func (s otel) WithGroup(name string) slog.Handler {
-	return otel{h: s.h.WithGroup(name)}
+	s.groupPrefix = joinKey(s.groupPrefix, name)
+	s.h = s.h.WithGroup(name)
+	return s
 }
 
 func (s otel) Handle(ctx context.Context, r slog.Record) (err error) {
 	if ctx == nil {
 		return s.h.Handle(ctx, r)
 	}
+
+	if ctxAttrs := collectCtxAttrs(ctx); len(ctxAttrs) > 0 {
+		r.AddAttrs(ctxAttrs...)
+	}
+
+	if s.baggage {
+		for _, m := range baggage.FromContext(ctx).Members() {
+			r.AddAttrs(slog.Attr{Key: s.baggageKeyPrefix + m.Key(), Value: slog.StringValue(m.Value())})
+		}
+	}
+
 	span := trace.SpanFromContext(ctx)
+
+	if (s.mode == ModeLogsBridge || s.mode == ModeBoth) && s.logger != nil {
+		s.emitLogsBridge(ctx, r)
+	}
+
 	if !span.IsRecording() {
 		return s.h.Handle(ctx, r)
 	}
@@ -94,57 +356,89 @@ func (s otel) Handle(ctx context.Context, r slog.Record) (err error) {
 	if sCtx.HasTraceID() {
 		attrs = append(
 			attrs,
-			slog.Attr{Key: "traceId", Value: slog.StringValue(sCtx.TraceID().String())},
+			slog.Attr{Key: s.keys.TraceID, Value: slog.StringValue(sCtx.TraceID().String())},
+			slog.Attr{Key: s.keys.TraceFlags, Value: slog.StringValue(sCtx.TraceFlags().String())},
 		)
 	}
 	if sCtx.HasSpanID() {
 		attrs = append(
 			attrs,
-			slog.Attr{Key: "spanId", Value: slog.StringValue(sCtx.SpanID().String())},
+			slog.Attr{Key: s.keys.SpanID, Value: slog.StringValue(sCtx.SpanID().String())},
 		)
 	}
 	if len(attrs) > 0 {
 		r.AddAttrs(attrs...)
 	}
 
-	{
+	if s.mode == ModeSpanEvent || s.mode == ModeBoth {
 		// (b) adds logs to the active span as events.
 
 		// code from: https://github.com/uptrace/opentelemetry-go-extra/tree/main/otellogrus
 		// which is BSD 2-Clause license.
 
-		attrs := make([]attribute.KeyValue, 0)
-
-		logSeverityKey := attribute.Key("log.severity")
-		logMessageKey := attribute.Key("log.message")
-		attrs = append(attrs, logSeverityKey.String(r.Level.String()))
-		attrs = append(attrs, logMessageKey.String(r.Message))
-
-		// TODO: Obey the following rules from the slog documentation:
-		//
-		// Handle methods that produce output should observe the following rules:
-		//   - If r.Time is the zero time, ignore the time.
-		//   - If an Attr's key is the empty string, ignore the Attr.
-		//
-		r.Attrs(func(a slog.Attr) bool {
-			if a.Key == "" {
-				return true
-			}
+		spanAttrs := make([]attribute.KeyValue, 0, len(s.preSpanAttrs)+3)
 
-			attrs = append(attrs,
-				attribute.KeyValue{
-					Key:   attribute.Key(a.Key),
-					Value: attribute.StringValue(a.Value.String()),
-				},
-			)
-			return true
-		})
-
-		span.AddEvent("log", trace.WithAttributes(attrs...))
-		if r.Level >= slog.LevelError {
+		severityText, severityNumber := s.severityMapper(r.Level)
+		spanAttrs = append(spanAttrs, attribute.Key(s.keys.Severity).String(severityText))
+		spanAttrs = append(spanAttrs, attribute.Key(s.keys.SeverityNumber).Int(severityNumber))
+		spanAttrs = append(spanAttrs, attribute.Key(s.keys.Body).String(r.Message))
+
+		spanAttrs = append(spanAttrs, s.preSpanAttrs...)
+		// Baggage members were already merged into r via r.AddAttrs above, so they're
+		// picked up here through recordAttrs(r); don't add them a second time.
+		spanAttrs = append(spanAttrs, flattenAttrs(s.groupPrefix, recordAttrs(r), s.toAttribute)...)
+
+		span.AddEvent("log", trace.WithAttributes(spanAttrs...))
+	}
+
+	if r.Level >= slog.LevelError {
+		if s.errorStatus {
 			span.SetStatus(codes.Error, r.Message)
 		}
+		if s.recordException {
+			if recErr, ok := findError(r); ok {
+				span.RecordError(recErr, trace.WithStackTrace(true))
+			}
+		}
 	}
 
 	return s.h.Handle(ctx, r)
 }
+
+// emitLogsBridge maps r to a log.Record and emits it through s.logger. This is the OTel Logs
+// Bridge emission path and runs regardless of whether a span is active.
+func (s otel) emitLogsBridge(ctx context.Context, r slog.Record) {
+	var lr log.Record
+	if !r.Time.IsZero() {
+		lr.SetTimestamp(r.Time)
+		lr.SetObservedTimestamp(r.Time)
+	}
+	severityText, severityNumber := s.severityMapper(r.Level)
+	lr.SetSeverity(log.Severity(severityNumber))
+	lr.SetSeverityText(severityText)
+	lr.SetBody(log.StringValue(r.Message))
+
+	// Baggage members were already merged into r by Handle before emitLogsBridge was
+	// called, so they're picked up here through recordAttrs(r); don't add them again.
+	lr.AddAttributes(s.preLogAttrs...)
+	lr.AddAttributes(flattenAttrs(s.groupPrefix, recordAttrs(r), s.toLogKeyValue)...)
+
+	s.logger.Emit(ctx, lr)
+}
+
+// defaultSeverityMapper is the default SeverityMapper, following the OTel log data model's
+// severity number ranges: LevelTrace=1, Debug=5, Info=9, Warn=13, Error=17.
+func defaultSeverityMapper(level slog.Level) (text string, number int) {
+	switch {
+	case level < slog.LevelDebug:
+		return "TRACE", 1
+	case level < slog.LevelInfo:
+		return "DEBUG", 5
+	case level < slog.LevelWarn:
+		return "INFO", 9
+	case level < slog.LevelError:
+		return "WARN", 13
+	default:
+		return "ERROR", 17
+	}
+}
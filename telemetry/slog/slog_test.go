@@ -0,0 +1,197 @@
+package slog_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	otelslog "github.com/gostdlib/foundation/telemetry/slog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/exp/slog"
+)
+
+// newRecordingSpan starts a recording span backed by an in-memory SpanRecorder and returns the
+// context carrying it, a func to end the span, and the recorder to inspect ended spans with.
+func newRecordingSpan(t *testing.T) (context.Context, func(), *tracetest.SpanRecorder) {
+	t.Helper()
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	ctx, span := tp.Tracer("slog_test").Start(context.Background(), "span")
+	return ctx, func() { span.End() }, sr
+}
+
+func discardHandler() slog.Handler {
+	return slog.NewTextHandler(io.Discard, nil)
+}
+
+func TestBaggageAttrsAppearOnce(t *testing.T) {
+	ctx, end, sr := newRecordingSpan(t)
+
+	m, err := baggage.NewMember("tenant", "acme")
+	if err != nil {
+		t.Fatalf("baggage.NewMember: %v", err)
+	}
+	bag, err := baggage.New(m)
+	if err != nil {
+		t.Fatalf("baggage.New: %v", err)
+	}
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+
+	h := otelslog.NewOTEL(discardHandler(), otelslog.WithBaggage(true))
+	slog.New(h).InfoContext(ctx, "hello")
+	end()
+
+	spans := sr.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d ended spans, want 1", len(spans))
+	}
+	events := spans[0].Events()
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	count := 0
+	for _, a := range events[0].Attributes {
+		if a.Key == attribute.Key("baggage.tenant") {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("got %d baggage.tenant attributes, want 1", count)
+	}
+}
+
+func TestModeLogsBridgeWithoutLoggerDoesNotPanic(t *testing.T) {
+	h := otelslog.NewOTEL(discardHandler(), otelslog.WithMode(otelslog.ModeLogsBridge))
+	slog.New(h).InfoContext(context.Background(), "hello")
+}
+
+type fakeLogger struct {
+	log.Logger
+	records []log.Record
+}
+
+func (f *fakeLogger) Emit(_ context.Context, r log.Record) {
+	f.records = append(f.records, r)
+}
+
+type fakeLoggerProvider struct {
+	embedded.LoggerProvider
+	logger *fakeLogger
+}
+
+func (p fakeLoggerProvider) Logger(string, ...log.LoggerOption) log.Logger {
+	return p.logger
+}
+
+func TestModesEmitToExpectedSink(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       otelslog.Mode
+		wantEvents int
+		wantLogs   int
+	}{
+		{name: "span event only", mode: otelslog.ModeSpanEvent, wantEvents: 1, wantLogs: 0},
+		{name: "logs bridge only", mode: otelslog.ModeLogsBridge, wantEvents: 0, wantLogs: 1},
+		{name: "both", mode: otelslog.ModeBoth, wantEvents: 1, wantLogs: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, end, sr := newRecordingSpan(t)
+			fl := &fakeLogger{}
+
+			h := otelslog.NewOTELWithLoggerProvider(discardHandler(), fakeLoggerProvider{logger: fl}, otelslog.WithMode(test.mode))
+			slog.New(h).InfoContext(ctx, "hello")
+			end()
+
+			gotEvents := sr.Ended()[0].Events()
+			if len(gotEvents) != test.wantEvents {
+				t.Errorf("got %d span events, want %d", len(gotEvents), test.wantEvents)
+			}
+			if len(fl.records) != test.wantLogs {
+				t.Errorf("got %d logs bridge records, want %d", len(fl.records), test.wantLogs)
+			}
+		})
+	}
+}
+
+func TestEnabledHonorsMinLevelAndWrappedHandler(t *testing.T) {
+	wrapped := slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelWarn})
+
+	h := otelslog.NewOTEL(wrapped, otelslog.WithMinLevel(slog.LevelError))
+	ctx := context.Background()
+
+	if h.Enabled(ctx, slog.LevelWarn) {
+		t.Error("Enabled(Warn) = true, want false: WithMinLevel(Error) should reject it")
+	}
+	if !h.Enabled(ctx, slog.LevelError) {
+		t.Error("Enabled(Error) = false, want true")
+	}
+
+	// With no WithMinLevel, the wrapped handler's own level floor still applies.
+	h2 := otelslog.NewOTEL(wrapped)
+	if h2.Enabled(ctx, slog.LevelInfo) {
+		t.Error("Enabled(Info) = true, want false: wrapped handler is configured for Warn and above")
+	}
+}
+
+func TestLogsBridgePreservesSliceTypes(t *testing.T) {
+	fl := &fakeLogger{}
+	h := otelslog.NewOTELWithLoggerProvider(discardHandler(), fakeLoggerProvider{logger: fl})
+	slog.New(h).InfoContext(context.Background(), "hello", slog.Any("tags", []string{"a", "b"}))
+
+	if len(fl.records) != 1 {
+		t.Fatalf("got %d logs bridge records, want 1", len(fl.records))
+	}
+
+	var found bool
+	fl.records[0].WalkAttributes(func(kv log.KeyValue) bool {
+		if kv.Key != "tags" {
+			return true
+		}
+		found = true
+		if kv.Value.Kind() != log.KindSlice {
+			t.Errorf("tags kind = %v, want %v", kv.Value.Kind(), log.KindSlice)
+		}
+		return true
+	})
+	if !found {
+		t.Error("tags attribute not found on emitted record")
+	}
+}
+
+func TestGroupAndAttrFlattening(t *testing.T) {
+	ctx, end, sr := newRecordingSpan(t)
+
+	h := otelslog.NewOTEL(discardHandler())
+	logger := slog.New(h).With(slog.String("id", "123")).WithGroup("req").With(slog.String("path", "/x"))
+	logger.InfoContext(ctx, "hello", slog.Group("meta", slog.String("a", "b")))
+	end()
+
+	got := map[attribute.Key]attribute.Value{}
+	for _, a := range sr.Ended()[0].Events()[0].Attributes {
+		got[a.Key] = a.Value
+	}
+
+	want := map[attribute.Key]string{
+		"id":         "123",
+		"req.path":   "/x",
+		"req.meta.a": "b",
+	}
+	for k, v := range want {
+		av, ok := got[k]
+		if !ok {
+			t.Errorf("missing attribute %q", k)
+			continue
+		}
+		if av.AsString() != v {
+			t.Errorf("attribute %q = %q, want %q", k, av.AsString(), v)
+		}
+	}
+}